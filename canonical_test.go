@@ -0,0 +1,57 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestWithCanonicalIsByteStableAndSortsKeys(t *testing.T) {
+	var r = Result[widgetRecord]{Page: 1, RPP: 1, Payload: []widgetRecord{{Name: "Widget", Price: 10}}}
+	var first, err1 = r.WithCanonical("*", "-password").MarshalJSON()
+	var second, err2 = r.WithCanonical("*", "-password").MarshalJSON()
+	if nil != err1 || nil != err2 {
+		t.Fatalf("MarshalJSON errors: %v, %v", err1, err2)
+	}
+	if string(first) != string(second) {
+		t.Fatalf("expected byte-stable output across calls, got %s vs %s", first, second)
+	}
+	var want = `{"page":1,"rpp":1,"payload":[{"name":"Widget","price":10}]}`
+	if want != string(first) {
+		t.Fatalf("expected keys in lexicographic order, got %s", first)
+	}
+}
+
+func TestWithCanonicalRendersUUIDLikePlainJSON(t *testing.T) {
+	var id = uuid.New()
+	var r = Result[User]{Page: 1, RPP: 1, Payload: []User{{ID: id, FirstName: "Jane"}}}
+
+	var canonical, err = r.WithCanonical("id", "first_name").MarshalJSON()
+	if nil != err {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+
+	var plain, errPlain = json.Marshal(r.With("id", "first_name").Payload[0])
+	if nil != errPlain {
+		t.Fatalf("json.Marshal: %v", errPlain)
+	}
+
+	var decodedCanonical struct {
+		Payload []map[string]any `json:"payload"`
+	}
+	if err := json.Unmarshal(canonical, &decodedCanonical); nil != err {
+		t.Fatalf("unmarshal canonical output: %v, body=%s", err, canonical)
+	}
+	var decodedPlain map[string]any
+	if err := json.Unmarshal(plain, &decodedPlain); nil != err {
+		t.Fatalf("unmarshal plain output: %v, body=%s", err, plain)
+	}
+
+	if decodedCanonical.Payload[0]["id"] != decodedPlain["id"] {
+		t.Fatalf("expected canonical id to match plain encoding, got %#v vs %#v", decodedCanonical.Payload[0]["id"], decodedPlain["id"])
+	}
+	if id.String() != decodedCanonical.Payload[0]["id"] {
+		t.Fatalf("expected canonical id to render as %q, got %#v", id.String(), decodedCanonical.Payload[0]["id"])
+	}
+}
@@ -1,17 +1,24 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
+	"encoding"
 	"encoding/json"
 	"fmt"
 	"github.com/google/uuid"
+	"io"
 	"log"
-	"math"
 	"os"
 	"reflect"
+	"runtime"
 	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
+	"unicode"
 )
 
 type User struct {
@@ -43,108 +50,476 @@ type Result[T any] struct {
 	Page    int64 `json:"page"`
 	RPP     int64 `json:"rpp"`
 	Payload []T   `json:"payload"`
+
+	// options tunes the concurrency of With and WithStrategy; set via WithOptions.
+	options Options
+}
+
+// WithOptions returns a copy of r that runs With and WithStrategy with opts instead of
+// the package defaults, e.g. r.WithOptions(Options{Parallelism: 1}) to disable
+// concurrency for a small payload, or r.WithOptions(Options{MinBatch: 500}) to give each
+// worker a larger batch on a high-core-count host.
+func (r Result[T]) WithOptions(opts Options) Result[T] {
+	r.options = opts
+	return r
+}
+
+// pathSegment is one token of a dotted selector path, e.g. "city" in "company.address.city",
+// or the "tags" name and 0 index embedded in "tags[0]".
+type pathSegment struct {
+	name     string
+	index    int
+	hasIndex bool
+}
+
+// parseSelector tokenizes a dotted selector path such as "company.address.city" or
+// "metadata.tags[0]" into its path segments.
+func parseSelector(raw string) []pathSegment {
+	var parts = strings.Split(raw, ".")
+	var segments = make([]pathSegment, 0, len(parts))
+	for _, part := range parts {
+		var segment = pathSegment{name: part}
+		if open := strings.IndexByte(part, '['); open >= 0 && strings.HasSuffix(part, "]") {
+			if idx, err := strconv.Atoi(part[open+1 : len(part)-1]); nil == err {
+				segment.name = part[:open]
+				segment.index = idx
+				segment.hasIndex = true
+			}
+		}
+		segments = append(segments, segment)
+	}
+	return segments
+}
+
+// fieldSelector is a parsed field selector: the trimmed raw path as given by the caller
+// plus its tokenized path segments, ready for (possibly nested) projection.
+type fieldSelector struct {
+	raw      string
+	segments []pathSegment
+}
+
+// NamingStrategy resolves the selector key under which a struct field is addressed,
+// decoupling field matching from the library's original hardcoded `json`-tag/snake_case
+// convention. Map payloads are unaffected by NamingStrategy since they have no fields
+// to derive keys from.
+type NamingStrategy interface {
+	// Name identifies the strategy, e.g. for RegisterStrategy lookups and diagnostics.
+	Name() string
+	// FieldKey returns the selector key under which field is addressed.
+	FieldKey(field reflect.StructField) string
+}
+
+// tagNamingStrategy resolves a field's key by reading an arbitrary struct tag.
+type tagNamingStrategy struct {
+	name string
+	tag  string
+}
+
+func (s tagNamingStrategy) Name() string { return s.name }
+
+func (s tagNamingStrategy) FieldKey(field reflect.StructField) string {
+	return strings.Trim(strings.Split(field.Tag.Get(s.tag), ",")[0], " ")
+}
+
+// caseNamingStrategy resolves a field's key by rendering its Go name in a given case.
+type caseNamingStrategy struct {
+	name   string
+	render func(string) string
 }
 
-// sanitizedField represents a pair of strings where the first string is the snake_case version
-// of a field and the second string is the corresponding PascalCase version.
-type sanitizedField [2]string
+func (s caseNamingStrategy) Name() string { return s.name }
 
-// snake returns the snake_case version of the sanitizedField.
-func (s sanitizedField) snake() string {
-	return s[0]
+func (s caseNamingStrategy) FieldKey(field reflect.StructField) string {
+	return s.render(field.Name)
+}
+
+// SnakeCase selects fields by their `json` tag, matching this library's original behavior.
+var SnakeCase NamingStrategy = tagNamingStrategy{name: "snake", tag: "json"}
+
+// CamelCase selects fields by a camelCase rendering of the Go field name.
+var CamelCase NamingStrategy = caseNamingStrategy{name: "camel", render: toCamelCase}
+
+// KebabCase selects fields by a kebab-case rendering of the Go field name.
+var KebabCase NamingStrategy = caseNamingStrategy{name: "kebab", render: toKebabCase}
+
+// TagStrategy builds a NamingStrategy that selects fields by an arbitrary struct tag,
+// e.g. TagStrategy("db") or TagStrategy("xml") for DB DTOs or protobuf-generated structs.
+func TagStrategy(tag string) NamingStrategy {
+	return tagNamingStrategy{name: tag, tag: tag}
+}
+
+var strategyRegistry = map[string]NamingStrategy{}
+
+// RegisterStrategy makes strategy available under name for later lookup by callers that
+// select a strategy dynamically, e.g. from configuration, via StrategyByName.
+func RegisterStrategy(name string, strategy NamingStrategy) {
+	strategyRegistry[name] = strategy
+}
+
+// StrategyByName returns the NamingStrategy registered under name, and whether one was
+// found, e.g. StrategyByName(cfg.FieldNaming) to resolve a strategy chosen in
+// configuration rather than hardcoded in source.
+func StrategyByName(name string) (NamingStrategy, bool) {
+	strategy, ok := strategyRegistry[name]
+	return strategy, ok
+}
+
+func init() {
+	RegisterStrategy(SnakeCase.Name(), SnakeCase)
+	RegisterStrategy(CamelCase.Name(), CamelCase)
+	RegisterStrategy(KebabCase.Name(), KebabCase)
+}
+
+// splitWords splits a PascalCase/camelCase Go identifier into its constituent words.
+func splitWords(name string) []string {
+	var words []string
+	var word strings.Builder
+	var runes = []rune(name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) && word.Len() > 0 && (!unicode.IsUpper(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			words = append(words, word.String())
+			word.Reset()
+		}
+		word.WriteRune(r)
+	}
+	if word.Len() > 0 {
+		words = append(words, word.String())
+	}
+	return words
+}
+
+func toCamelCase(name string) string {
+	var words = splitWords(name)
+	var b strings.Builder
+	for i, word := range words {
+		word = strings.ToLower(word)
+		if i > 0 && "" != word {
+			word = strings.ToUpper(word[:1]) + word[1:]
+		}
+		b.WriteString(word)
+	}
+	return b.String()
 }
 
-// pascal returns the PascalCase version of the sanitizedField.
-func (s sanitizedField) pascal() string {
-	return s[1]
+func toKebabCase(name string) string {
+	return strings.ToLower(strings.Join(splitWords(name), "-"))
 }
 
-var contains = func(haystack []sanitizedField, needle string) bool {
-	return slices.ContainsFunc(haystack, func(s sanitizedField) bool {
-		return needle == s.snake()
-	})
+// fieldPlan is the parsed result of a With/WithStrategy field list: the explicit
+// selectors to include, the selectors to drop (the "-field" syntax), and whether a "*"
+// wildcard selector requests all of a record's top-level fields.
+type fieldPlan struct {
+	wildcard bool
+	includes []fieldSelector
+	excludes []fieldSelector
 }
 
-// sanitizeFields takes a variable number of field names and returns a slice of sanitized fields.
-// If T == reflect.Struct, it returns a []sanitizedField with the snake and pascal version of each field.
-// If T == reflect.Map, it returns a []sanitizedField only with the snake version of each field.
-func sanitizeFields[T any](fields ...string) []sanitizedField {
+// sanitizeFields takes a variable number of (possibly comma-separated, dotted, wildcard
+// or "-excluded") field selectors and resolves them into a fieldPlan, ready for
+// projection against T via SnakeCase.
+func sanitizeFields[T any](fields ...string) fieldPlan {
+	return sanitizeFieldsWithStrategy[T](SnakeCase, fields...)
+}
+
+// sanitizeFieldsWithStrategy is like sanitizeFields but threads strategy through so that
+// struct fields encountered while walking a selector, or while expanding a "*" wildcard,
+// are matched via strategy instead of the hardcoded `json` tag. Blank and duplicate
+// selectors are dropped; unknown or malformed include paths are kept here and silently
+// skipped later, at projection time, once they're checked against an actual value.
+func sanitizeFieldsWithStrategy[T any](strategy NamingStrategy, fields ...string) fieldPlan {
 	var dummy T
 	var t = reflect.TypeOf(dummy)
-	var sanitizedFields []sanitizedField
-	switch t.Kind() {
-	default:
-		return sanitizedFields
-	case reflect.Struct:
-		for _, field := range fields {
+	var plan fieldPlan
+	if nil == t || (reflect.Struct != t.Kind() && reflect.Map != t.Kind()) {
+		return plan
+	}
+	for _, raw := range fields {
+		for _, field := range strings.Split(raw, ",") {
 			field = strings.Trim(field, "\n\a\b\f\r\t\v ")
-			if "" == field {
+			switch {
+			case "" == field:
 				continue
-			}
-			for i := 0; i < t.NumField(); i++ {
-				var sanitized = sanitizedField{}
-				var record = t.Field(i)
-				var tagValue = strings.Trim(strings.Split(record.Tag.Get("json"), ",")[0], " ")
-				sanitized[0] = tagValue
-				sanitized[1] = record.Name
-				if sanitized.snake() == field && !contains(sanitizedFields, sanitized.snake()) {
-					sanitizedFields = append(sanitizedFields, sanitized)
+			case "*" == field:
+				plan.wildcard = true
+			case strings.HasPrefix(field, "-"):
+				field = strings.Trim(field[1:], "\n\a\b\f\r\t\v ")
+				if "" == field || slices.ContainsFunc(plan.excludes, func(s fieldSelector) bool { return s.raw == field }) {
+					continue
 				}
+				plan.excludes = append(plan.excludes, fieldSelector{raw: field, segments: parseSelector(field)})
+			default:
+				if slices.ContainsFunc(plan.includes, func(s fieldSelector) bool { return s.raw == field }) {
+					continue
+				}
+				plan.includes = append(plan.includes, fieldSelector{raw: field, segments: parseSelector(field)})
 			}
 		}
-	case reflect.Map:
-		for _, key := range fields {
-			key = strings.Trim(key, "\n\a\b\f\r\t\v ")
-			if "" != key && !contains(sanitizedFields, key) {
-				var sanitized = sanitizedField{}
-				sanitized[0] = key
-				sanitizedFields = append(sanitizedFields, sanitized)
+	}
+	if plan.wildcard && reflect.Struct == t.Kind() {
+		for key := range structFieldIndex(t, strategy) {
+			if slices.ContainsFunc(plan.includes, func(s fieldSelector) bool { return s.raw == key }) {
+				continue
 			}
+			plan.includes = append(plan.includes, fieldSelector{raw: key, segments: []pathSegment{{name: key}}})
 		}
 	}
-	return sanitizedFields
+	return plan
+}
+
+// defaultMinBatch is the number of records in each batch handed to a worker when
+// Options.MinBatch isn't set; the last batch holds the remainder and can be smaller.
+const defaultMinBatch = 25
+
+// Options tunes the concurrency of the With-family transforms.
+type Options struct {
+	// Parallelism caps the number of worker goroutines. 0 (the default) uses
+	// runtime.GOMAXPROCS(0).
+	Parallelism int
+	// MinBatch is the number of records a single worker is given in one batch; only the
+	// last batch, which holds the remainder, can be smaller. 0 (the default) uses
+	// defaultMinBatch. Set Parallelism to 1 to disable concurrency.
+	MinBatch int
+}
+
+// batching resolves opts (filling in defaults) into the batch size and worker count to
+// use for a payload of payloadSize records: the payload is split into
+// ceil(payloadSize/batchSize) batches, worked by min(parallelism, batchCount) workers
+// pulled from a shared job queue, so a worker that finishes early picks up the next
+// unstarted batch instead of idling.
+func (o Options) batching(payloadSize int) (batchSize, batchCount, workers int) {
+	batchSize = o.MinBatch
+	if batchSize <= 0 {
+		batchSize = defaultMinBatch
+	}
+	if batchSize > payloadSize {
+		batchSize = payloadSize
+	}
+	batchCount = (payloadSize + batchSize - 1) / batchSize
+	var parallelism = o.Parallelism
+	if parallelism <= 0 {
+		parallelism = runtime.GOMAXPROCS(0)
+	}
+	workers = min(parallelism, batchCount)
+	if workers < 1 {
+		workers = 1
+	}
+	return batchSize, batchCount, workers
+}
+
+// fieldIndexCacheKey identifies one (struct type, naming strategy) pair in
+// fieldIndexCache. strategy is keyed by Name() rather than by value since a
+// caseNamingStrategy holds a func field and so isn't comparable.
+type fieldIndexCacheKey struct {
+	t    reflect.Type
+	name string
 }
 
-func computeDelta(payloadSize int) int {
-	var size = float64(payloadSize)
-	switch {
-	case size <= 1 || size <= math.Pow(10, 2):
-		return 1
-	case size <= math.Pow(10, 3):
-		return 2
-	case size <= math.Pow(10, 4):
-		return 3
+// fieldIndexCache memoizes, per (struct type, naming strategy) pair, the selector key
+// each field resolves to under that strategy. Without it, every resolveSelector call
+// would re-walk t.NumField() and re-render each field's key from scratch for every
+// record, even though both are fixed for the lifetime of T.
+var fieldIndexCache sync.Map // fieldIndexCacheKey -> map[string]int
+
+// structFieldIndex returns the cached selector-key-to-field-index map for t under
+// strategy, computing and storing it on first use. Unexported fields are never
+// indexed, since reflect.Value.Interface panics on them; nor is a field whose key
+// resolves to "-", the idiomatic tag value (e.g. `json:"-"`) for "never serialize this
+// field" — a "*" wildcard must not re-expose what the struct author explicitly hid.
+func structFieldIndex(t reflect.Type, strategy NamingStrategy) map[string]int {
+	var cacheKey = fieldIndexCacheKey{t: t, name: strategy.Name()}
+	if cached, ok := fieldIndexCache.Load(cacheKey); ok {
+		return cached.(map[string]int)
+	}
+	var index = make(map[string]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		var field = t.Field(i)
+		if "" != field.PkgPath {
+			continue
+		}
+		var key = strings.Trim(strategy.FieldKey(field), " ")
+		if "" == key || "-" == key {
+			continue
+		}
+		if _, exists := index[key]; !exists {
+			index[key] = i
+		}
+	}
+	var stored, _ = fieldIndexCache.LoadOrStore(cacheKey, index)
+	return stored.(map[string]int)
+}
+
+// indexInto dereferences pointers and interfaces in v and, if it's then a slice or array,
+// returns its element at index. Returns the zero Value on a nil pointer, an out-of-range
+// index, or any other kind.
+func indexInto(v reflect.Value, index int) reflect.Value {
+	for reflect.Ptr == v.Kind() || reflect.Interface == v.Kind() {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	if (reflect.Slice == v.Kind() || reflect.Array == v.Kind()) && index >= 0 && index < v.Len() {
+		return v.Index(index)
+	}
+	return reflect.Value{}
+}
+
+// resolveSelector walks v along segments, descending into struct fields (matched via
+// strategy), map values (matched by string key) and slice/array elements (matched by
+// index), dereferencing pointers along the way. It reports ok=false for an invalid or
+// out-of-range path so callers can skip it; a nil pointer anywhere along a path resolves
+// to a nil value rather than failing, and the segments past it are not checked, so an
+// invalid remainder behind a nil pointer also resolves to nil. consumed reports how many
+// of segments were actually traversed before the path resolved or ran into the nil
+// pointer, so a caller projecting the result back onto a nested path, such as
+// setNested, can stop at the segment that went nil instead of rebuilding the rest of the
+// path around a null leaf.
+func resolveSelector(v reflect.Value, segments []pathSegment, strategy NamingStrategy) (value any, ok bool, consumed int) {
+	for reflect.Ptr == v.Kind() || reflect.Interface == v.Kind() {
+		if v.IsNil() {
+			return nil, true, 0
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil, false, 0
+	}
+	if 0 == len(segments) {
+		return v.Interface(), true, 0
+	}
+	var segment = segments[0]
+	var rest = segments[1:]
+	switch v.Kind() {
+	case reflect.Struct:
+		var i, ok = structFieldIndex(v.Type(), strategy)[segment.name]
+		if !ok {
+			return nil, false, 0
+		}
+		var fieldValue = v.Field(i)
+		if segment.hasIndex {
+			fieldValue = indexInto(fieldValue, segment.index)
+			if !fieldValue.IsValid() {
+				return nil, false, 0
+			}
+		}
+		var restValue, restOk, restConsumed = resolveSelector(fieldValue, rest, strategy)
+		return restValue, restOk, 1 + restConsumed
+	case reflect.Map:
+		var key = reflect.ValueOf(segment.name)
+		if !key.Type().AssignableTo(v.Type().Key()) {
+			return nil, false, 0
+		}
+		var mapValue = v.MapIndex(key)
+		if !mapValue.IsValid() {
+			return nil, false, 0
+		}
+		if segment.hasIndex {
+			mapValue = indexInto(mapValue, segment.index)
+			if !mapValue.IsValid() {
+				return nil, false, 0
+			}
+		}
+		var restValue, restOk, restConsumed = resolveSelector(mapValue, rest, strategy)
+		return restValue, restOk, 1 + restConsumed
 	default:
-		return 4
+		return nil, false, 0
 	}
 }
 
-func doTransform[T any](wg *sync.WaitGroup, kind reflect.Kind, out *[]map[string]any, in []T, fields []sanitizedField) {
-	defer wg.Done()
-	for _, entry := range in {
-		var entryValue = reflect.ValueOf(entry)
-		var entryFields = map[string]any{}
-		for _, field := range fields {
-			if reflect.Struct == kind {
-				var value = entryValue.FieldByName(field.pascal())
-				if value.IsValid() {
-					entryFields[field.snake()] = value.Interface()
-				}
-			} else if reflect.Map == kind {
-				for _, key := range entryValue.MapKeys() {
-					var value = entryValue.MapIndex(key)
-					if field.snake() == key.String() {
-						entryFields[field.snake()] = value.Interface()
-					}
-				}
+// setNested writes value into dest at the path described by segments, creating
+// intermediate map[string]any levels as needed and reusing (rather than overwriting) an
+// intermediate level already created by an earlier selector that shares a parent path.
+func setNested(dest map[string]any, segments []pathSegment, value any) {
+	if 1 == len(segments) {
+		dest[segments[0].name] = value
+		return
+	}
+	var nested, ok = dest[segments[0].name].(map[string]any)
+	if !ok {
+		nested = map[string]any{}
+		dest[segments[0].name] = nested
+	}
+	setNested(nested, segments[1:], value)
+}
+
+// deleteNested removes the value at the path described by segments from dest, walking
+// into nested map[string]any levels the same way setNested builds them, so an exclude
+// selector like "company.internal_id" drops only that nested key rather than the whole
+// "company" object. A path whose intermediate level is missing or isn't itself a
+// map[string]any is silently ignored, matching the unknown-path handling elsewhere.
+func deleteNested(dest map[string]any, segments []pathSegment) {
+	if 1 == len(segments) {
+		delete(dest, segments[0].name)
+		return
+	}
+	var nested, ok = dest[segments[0].name].(map[string]any)
+	if !ok {
+		return
+	}
+	deleteNested(nested, segments[1:])
+}
+
+// projectFields builds the map[string]any projection of a single record of the given
+// kind, applying plan: each include selector is resolved and merged in (dotted paths
+// become nested maps), a "*" wildcard additionally brings in every key of a Map-kind
+// record, and excludes are then dropped at their own (possibly nested) path. Unknown or
+// out-of-range paths are silently skipped.
+func projectFields(strategy NamingStrategy, kind reflect.Kind, entryValue reflect.Value, plan fieldPlan) map[string]any {
+	var entryFields = map[string]any{}
+	for _, selector := range plan.includes {
+		var value, ok, consumed = resolveSelector(entryValue, selector.segments, strategy)
+		if !ok {
+			continue
+		}
+		if consumed < 1 {
+			consumed = 1
+		}
+		setNested(entryFields, selector.segments[:consumed], value)
+	}
+	if plan.wildcard && reflect.Map == kind {
+		for _, key := range entryValue.MapKeys() {
+			var name = key.String()
+			if _, already := entryFields[name]; !already {
+				entryFields[name] = entryValue.MapIndex(key).Interface()
 			}
 		}
-		*out = append(*out, entryFields)
 	}
+	for _, exclude := range plan.excludes {
+		deleteNested(entryFields, exclude.segments)
+	}
+	return entryFields
 }
 
-// With transforms the result payload by selecting only the specified fields.
+func doTransform[T any](strategy NamingStrategy, kind reflect.Kind, out *[]map[string]any, in []T, plan fieldPlan) {
+	for _, entry := range in {
+		*out = append(*out, projectFields(strategy, kind, reflect.ValueOf(entry), plan))
+	}
+}
+
+// With transforms the result payload by selecting only the specified fields, matching
+// struct fields against their `json` tag via SnakeCase. Fields may be dotted paths, e.g.
+// "company.address.city" or "metadata.tags[0]", to project into nested structs, maps and
+// slice elements. A "*" selector includes all of a record's top-level fields, and a
+// "-field" selector excludes one, so r.With("*", "-password") or the equivalent
+// r.With("*, -password") both work. Use WithStrategy to select fields by a different
+// naming convention.
 func (r Result[T]) With(fields ...string) *Result[map[string]any] {
+	return r.transform(SnakeCase, sanitizeFields[T](fields...), fields...)
+}
+
+// WithStrategy is like With but resolves struct field keys through strategy instead of
+// the default SnakeCase (`json` tag) convention, e.g. WithStrategy(CamelCase, "firstName")
+// or WithStrategy(TagStrategy("db"), "first_name").
+func (r Result[T]) WithStrategy(strategy NamingStrategy, fields ...string) *Result[map[string]any] {
+	return r.transform(strategy, sanitizeFieldsWithStrategy[T](strategy, fields...), fields...)
+}
+
+// transform drives the field-projection shared by With and WithStrategy; fields is only
+// consulted to short-circuit on an empty selector list. The payload is split into
+// batches sized per r.options (see Options.batching) and worked by a bounded pool of
+// goroutines that pull the next unstarted batch off a shared queue, so a worker that
+// finishes early steals the next batch instead of idling while others are still busy.
+func (r Result[T]) transform(strategy NamingStrategy, plan fieldPlan, fields ...string) *Result[map[string]any] {
 	var transformed = &Result[map[string]any]{Page: r.Page, RPP: r.RPP, Payload: make([]map[string]any, 0)}
 	var payloadSize = len(r.Payload)
 	if 0 == len(fields) || nil == r.Payload || 0 == payloadSize {
@@ -154,30 +529,229 @@ func (r Result[T]) With(fields ...string) *Result[map[string]any] {
 	if reflect.Struct != kind && reflect.Map != kind {
 		return transformed
 	}
-	var delta = computeDelta(payloadSize)
-	var chunkSize = payloadSize / delta
-	var chunks = make([][]map[string]any, delta)
-	for i := 0; i < delta; i++ {
-		chunks[i] = make([]map[string]any, 0, chunkSize)
+	var batchSize, batchCount, workers = r.options.batching(payloadSize)
+	var batches = make([][]map[string]any, batchCount)
+	var jobs = make(chan int, batchCount)
+	for i := 0; i < batchCount; i++ {
+		jobs <- i
 	}
-	transformed.Payload = make([]map[string]any, 0, payloadSize)
+	close(jobs)
 	var wg = sync.WaitGroup{}
-	wg.Add(delta)
-	var sanitizedFields = sanitizeFields[T](fields...)
-	for i := 0; i < delta; i++ {
-		var end = (1 + i) * chunkSize
-		if 1+i == delta {
-			end = payloadSize
-		}
-		go doTransform[T](&wg, kind, &chunks[i], r.Payload[i*chunkSize:end], sanitizedFields)
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for batch := range jobs {
+				var start = batch * batchSize
+				var end = start + batchSize
+				if end > payloadSize {
+					end = payloadSize
+				}
+				batches[batch] = make([]map[string]any, 0, end-start)
+				doTransform[T](strategy, kind, &batches[batch], r.Payload[start:end], plan)
+			}
+		}()
 	}
 	wg.Wait()
-	for i := 0; i < delta; i++ {
-		transformed.Payload = append(transformed.Payload, chunks[i]...)
+	transformed.Payload = make([]map[string]any, 0, payloadSize)
+	for _, batch := range batches {
+		transformed.Payload = append(transformed.Payload, batch...)
 	}
 	return transformed
 }
 
+// StreamResult pairs a Result with a field projection resolved once against T,
+// letting callers write the projected JSON envelope to an io.Writer without
+// materializing the full []map[string]any payload that With builds in memory.
+type StreamResult[T any] struct {
+	result   Result[T]
+	strategy NamingStrategy
+	plan     fieldPlan
+	kind     reflect.Kind
+}
+
+// WithStream resolves fields against T once and returns a StreamResult bound to
+// that projection, ready to be written with WriteJSON.
+func (r Result[T]) WithStream(fields ...string) *StreamResult[T] {
+	var stream = &StreamResult[T]{result: r, strategy: SnakeCase, plan: sanitizeFields[T](fields...)}
+	if len(r.Payload) > 0 {
+		stream.kind = reflect.TypeOf(r.Payload[0]).Kind()
+	}
+	return stream
+}
+
+// WriteJSON writes the "page"/"rpp"/"payload" envelope to w, encoding each payload
+// element on-the-fly with a single json.Encoder so memory stays bounded regardless
+// of payload size.
+func (s *StreamResult[T]) WriteJSON(w io.Writer) error {
+	var bw = bufio.NewWriter(w)
+	if _, err := fmt.Fprintf(bw, `{"page":%d,"rpp":%d,"payload":[`, s.result.Page, s.result.RPP); nil != err {
+		return err
+	}
+	if (len(s.plan.includes) > 0 || s.plan.wildcard) && (reflect.Struct == s.kind || reflect.Map == s.kind) {
+		var enc = json.NewEncoder(bw)
+		for i, entry := range s.result.Payload {
+			if i > 0 {
+				if _, err := bw.WriteString(","); nil != err {
+					return err
+				}
+			}
+			if err := enc.Encode(projectFields(s.strategy, s.kind, reflect.ValueOf(entry), s.plan)); nil != err {
+				return err
+			}
+		}
+	}
+	if _, err := bw.WriteString("]}"); nil != err {
+		return err
+	}
+	return bw.Flush()
+}
+
+// WriteJSON projects the result onto fields and streams it to w element-by-element,
+// equivalent to r.WithStream(fields...).WriteJSON(w) but convenient for one-shot use.
+func (r Result[T]) WriteJSON(w io.Writer, fields ...string) error {
+	return r.WithStream(fields...).WriteJSON(w)
+}
+
+// CanonicalResult is a Result whose JSON encoding is byte-stable: object keys are
+// written in lexicographic order rather than map[string]any's randomized iteration
+// order, floats render in fixed-point notation, time.Time values render as a fixed
+// RFC3339 UTC form, and no HTML escaping is applied. Useful for signing responses,
+// cache-key hashing, and snapshot tests. This applies to map[string]any objects,
+// slices, arrays, floats and time values; any other value, such as a whole struct
+// selected by a single selector, is written by encoding/json as-is, so its keys follow
+// struct field order and its nested floats and times are not normalized.
+type CanonicalResult[T any] struct {
+	Page    int64
+	RPP     int64
+	Payload []map[string]any
+}
+
+// WithCanonical is like With, projecting the result payload onto fields, but returns a
+// CanonicalResult whose MarshalJSON output is byte-stable across calls.
+func (r Result[T]) WithCanonical(fields ...string) *CanonicalResult[T] {
+	var projected = r.With(fields...)
+	return &CanonicalResult[T]{Page: projected.Page, RPP: projected.RPP, Payload: projected.Payload}
+}
+
+// MarshalJSON implements json.Marshaler, writing the "page"/"rpp"/"payload" envelope
+// with every map[string]any object's keys sorted lexicographically so the output is
+// reproducible byte for byte across calls and processes.
+func (r CanonicalResult[T]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(`{"page":`)
+	buf.WriteString(strconv.FormatInt(r.Page, 10))
+	buf.WriteString(`,"rpp":`)
+	buf.WriteString(strconv.FormatInt(r.RPP, 10))
+	buf.WriteString(`,"payload":[`)
+	for i, entry := range r.Payload {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := writeCanonicalValue(&buf, entry); nil != err {
+			return nil, err
+		}
+	}
+	buf.WriteString(`]}`)
+	return buf.Bytes(), nil
+}
+
+// writeCanonicalValue writes v to buf in canonical form, recursing into objects and
+// slices/arrays so every level is deterministic, not just the top one. A value
+// implementing json.Marshaler or encoding.TextMarshaler (e.g. uuid.UUID, whose
+// underlying kind is a [16]byte array) is deferred to its own marshaling rather than
+// rendered as a raw array, so canonical output matches what plain encoding/json produces
+// for the same value.
+func writeCanonicalValue(buf *bytes.Buffer, v any) error {
+	switch value := v.(type) {
+	case nil:
+		buf.WriteString("null")
+		return nil
+	case map[string]any:
+		return writeCanonicalObject(buf, value)
+	case time.Time:
+		return writeCanonicalLeaf(buf, value.UTC().Format(time.RFC3339Nano))
+	case *time.Time:
+		if nil == value {
+			buf.WriteString("null")
+			return nil
+		}
+		return writeCanonicalLeaf(buf, value.UTC().Format(time.RFC3339Nano))
+	case float32:
+		buf.Write(strconv.AppendFloat(nil, float64(value), 'f', -1, 32))
+		return nil
+	case float64:
+		buf.Write(strconv.AppendFloat(nil, value, 'f', -1, 64))
+		return nil
+	default:
+		if _, ok := v.(json.Marshaler); ok {
+			return writeCanonicalLeaf(buf, v)
+		}
+		if _, ok := v.(encoding.TextMarshaler); ok {
+			return writeCanonicalLeaf(buf, v)
+		}
+		var rv = reflect.ValueOf(v)
+		if rv.IsValid() && (reflect.Slice == rv.Kind() || reflect.Array == rv.Kind()) && reflect.TypeOf(v) != reflect.TypeOf([]byte(nil)) {
+			return writeCanonicalArray(buf, rv)
+		}
+		return writeCanonicalLeaf(buf, v)
+	}
+}
+
+// writeCanonicalObject writes object to buf with its keys sorted lexicographically.
+func writeCanonicalObject(buf *bytes.Buffer, object map[string]any) error {
+	var keys = make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	buf.WriteByte('{')
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := writeCanonicalLeaf(buf, key); nil != err {
+			return err
+		}
+		buf.WriteByte(':')
+		if err := writeCanonicalValue(buf, object[key]); nil != err {
+			return err
+		}
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+// writeCanonicalArray writes rv, a reflect.Slice or reflect.Array, to buf element by
+// element in its existing order.
+func writeCanonicalArray(buf *bytes.Buffer, rv reflect.Value) error {
+	buf.WriteByte('[')
+	for i := 0; i < rv.Len(); i++ {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := writeCanonicalValue(buf, rv.Index(i).Interface()); nil != err {
+			return err
+		}
+	}
+	buf.WriteByte(']')
+	return nil
+}
+
+// writeCanonicalLeaf marshals v (a string, number, bool, uuid.UUID, or any other
+// json.Marshaler) to buf with HTML escaping disabled, so output doesn't vary with
+// encoding/json's default escaping of '<', '>' and '&'.
+func writeCanonicalLeaf(buf *bytes.Buffer, v any) error {
+	var leaf bytes.Buffer
+	var enc = json.NewEncoder(&leaf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); nil != err {
+		return err
+	}
+	buf.Write(bytes.TrimRight(leaf.Bytes(), "\n"))
+	return nil
+}
+
 func parseUsersFromDisk(source string) *Result[User] {
 	fmt.Printf("Parsing mock data from %q... ", source)
 	var start = time.Now()
@@ -0,0 +1,78 @@
+package main
+
+import "testing"
+
+type nestedPtrCompany struct {
+	Name       string `json:"name"`
+	InternalID string `json:"internal_id"`
+}
+
+type nestedPtrRecord struct {
+	ID      int               `json:"id"`
+	Company *nestedPtrCompany `json:"company"`
+}
+
+type widgetRecord struct {
+	Name     string `json:"name"`
+	Price    int    `json:"price"`
+	Password string `json:"password"`
+	Hidden   string `json:"-"`
+}
+
+func TestWithNestedNilPointerStopsAtNilSegment(t *testing.T) {
+	var r = Result[nestedPtrRecord]{Payload: []nestedPtrRecord{{ID: 1, Company: nil}}}
+	var res = r.With("company.name", "id")
+	if 1 != len(res.Payload) {
+		t.Fatalf("expected 1 record, got %d", len(res.Payload))
+	}
+	var record = res.Payload[0]
+	if nil != record["company"] {
+		t.Fatalf(`expected "company" to be nil, got %#v`, record["company"])
+	}
+	if int64(1) != record["id"] && 1 != record["id"] {
+		t.Fatalf(`expected "id" to be 1, got %#v`, record["id"])
+	}
+}
+
+func TestWithNestedNonNilPointerResolves(t *testing.T) {
+	var r = Result[nestedPtrRecord]{Payload: []nestedPtrRecord{{ID: 1, Company: &nestedPtrCompany{Name: "Acme"}}}}
+	var res = r.With("company.name")
+	var company, ok = res.Payload[0]["company"].(map[string]any)
+	if !ok {
+		t.Fatalf(`expected "company" to be a nested object, got %#v`, res.Payload[0]["company"])
+	}
+	if "Acme" != company["name"] {
+		t.Fatalf(`expected company.name to be "Acme", got %#v`, company["name"])
+	}
+}
+
+func TestWithNestedExcludeDropsOnlyTheNestedKey(t *testing.T) {
+	var r = Result[nestedPtrRecord]{Payload: []nestedPtrRecord{{
+		ID:      1,
+		Company: &nestedPtrCompany{Name: "Acme", InternalID: "secret-id"},
+	}}}
+	var company, ok = r.With("company.name", "company.internal_id", "-company.internal_id").Payload[0]["company"].(map[string]any)
+	if !ok {
+		t.Fatalf(`expected "company" to still be a nested object, got %#v`, r.With("company.name", "-company.internal_id").Payload[0]["company"])
+	}
+	if "Acme" != company["name"] {
+		t.Fatalf(`expected company.name to survive the exclude, got %#v`, company["name"])
+	}
+	if _, ok := company["internal_id"]; ok {
+		t.Fatal(`expected company.internal_id to be excluded`)
+	}
+}
+
+func TestWithWildcardAndExclusion(t *testing.T) {
+	var r = Result[widgetRecord]{Payload: []widgetRecord{{Name: "Widget", Price: 10, Password: "secret", Hidden: "nope"}}}
+	var record = r.With("*", "-password").Payload[0]
+	if "Widget" != record["name"] {
+		t.Fatalf(`expected "name" to be "Widget", got %#v`, record["name"])
+	}
+	if _, ok := record["password"]; ok {
+		t.Fatal(`expected "password" to be excluded`)
+	}
+	if _, ok := record["-"]; ok {
+		t.Fatal(`expected a json:"-" field to never appear under wildcard expansion`)
+	}
+}
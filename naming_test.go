@@ -0,0 +1,73 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+type withUnexportedField struct {
+	Name   string `json:"name"`
+	secret string
+}
+
+func TestStrategyByNameBuiltins(t *testing.T) {
+	for _, name := range []string{"snake", "camel", "kebab"} {
+		strategy, ok := StrategyByName(name)
+		if !ok {
+			t.Fatalf("StrategyByName(%q): expected built-in strategy to be registered", name)
+		}
+		if strategy.Name() != name {
+			t.Fatalf("StrategyByName(%q): got strategy named %q", name, strategy.Name())
+		}
+	}
+}
+
+func TestStrategyByNameUnknown(t *testing.T) {
+	if _, ok := StrategyByName("does-not-exist"); ok {
+		t.Fatal("StrategyByName: expected ok=false for an unregistered name")
+	}
+}
+
+func TestStrategyByNameCustom(t *testing.T) {
+	var strategy = TagStrategy("xml")
+	RegisterStrategy("xml", strategy)
+	got, ok := StrategyByName("xml")
+	if !ok {
+		t.Fatal("StrategyByName(\"xml\"): expected the just-registered strategy to be found")
+	}
+	if got.Name() != strategy.Name() {
+		t.Fatalf("StrategyByName(\"xml\"): got %q, want %q", got.Name(), strategy.Name())
+	}
+}
+
+func TestWithStrategyCamelCase(t *testing.T) {
+	var r = Result[User]{Payload: []User{{FirstName: "Jane", LastName: "Doe"}}}
+	var record = r.WithStrategy(CamelCase, "firstName", "lastName").Payload[0]
+	if "Jane" != record["firstName"] {
+		t.Fatalf(`expected "firstName" to be "Jane", got %#v`, record["firstName"])
+	}
+	if "Doe" != record["lastName"] {
+		t.Fatalf(`expected "lastName" to be "Doe", got %#v`, record["lastName"])
+	}
+}
+
+func TestStructFieldIndexSkipsUnexportedFields(t *testing.T) {
+	var index = structFieldIndex(reflect.TypeOf(withUnexportedField{}), CamelCase)
+	if _, ok := index["secret"]; ok {
+		t.Fatal(`expected unexported field "secret" to not be indexed`)
+	}
+	if _, ok := index["name"]; !ok {
+		t.Fatal(`expected exported field "name" to be indexed`)
+	}
+}
+
+func TestWithStrategyWildcardSkipsUnexportedField(t *testing.T) {
+	var r = Result[withUnexportedField]{Payload: []withUnexportedField{{Name: "Jane", secret: "hidden"}}}
+	var record = r.WithStrategy(CamelCase, "*").Payload[0]
+	if "Jane" != record["name"] {
+		t.Fatalf(`expected "name" to be "Jane", got %#v`, record["name"])
+	}
+	if _, ok := record["secret"]; ok {
+		t.Fatal(`expected unexported field "secret" to not be projected`)
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+// BenchmarkStructFieldIndexCached exercises the steady-state path: the cache is warmed
+// once up front and every call after that is a sync.Map hit.
+func BenchmarkStructFieldIndexCached(b *testing.B) {
+	var t = reflect.TypeOf(User{})
+	structFieldIndex(t, SnakeCase)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		structFieldIndex(t, SnakeCase)
+	}
+}
+
+// BenchmarkStructFieldIndexUncached evicts the cache entry before every call, forcing
+// structFieldIndex to re-walk t.NumField() and re-render every field's key each time,
+// i.e. the cost this commit's cache was introduced to avoid.
+func BenchmarkStructFieldIndexUncached(b *testing.B) {
+	var t = reflect.TypeOf(User{})
+	var cacheKey = fieldIndexCacheKey{t: t, name: SnakeCase.Name()}
+	for i := 0; i < b.N; i++ {
+		fieldIndexCache.Delete(cacheKey)
+		structFieldIndex(t, SnakeCase)
+	}
+}
+
+func TestStructFieldIndexCacheIsReused(t *testing.T) {
+	var typ = reflect.TypeOf(User{})
+	var first = structFieldIndex(typ, SnakeCase)
+	var second = structFieldIndex(typ, SnakeCase)
+	if reflect.ValueOf(first).Pointer() != reflect.ValueOf(second).Pointer() {
+		t.Fatal("expected structFieldIndex to return the same cached map on repeated calls")
+	}
+}
+
+func TestStructFieldIndexPerStrategy(t *testing.T) {
+	var typ = reflect.TypeOf(User{})
+	var snake = structFieldIndex(typ, SnakeCase)
+	var camel = structFieldIndex(typ, CamelCase)
+	if _, ok := snake["firstName"]; ok {
+		t.Fatal("expected the snake-case index to not contain camelCase keys")
+	}
+	if _, ok := camel["first_name"]; ok {
+		t.Fatal("expected the camelCase index to not contain snake_case keys")
+	}
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestWriteJSONStreamsEnvelope(t *testing.T) {
+	var r = Result[widgetRecord]{Page: 2, RPP: 1, Payload: []widgetRecord{{Name: "Widget", Price: 10, Password: "secret"}}}
+	var buf bytes.Buffer
+	if err := r.WriteJSON(&buf, "name", "price"); nil != err {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+	var decoded struct {
+		Page    int64            `json:"page"`
+		RPP     int64            `json:"rpp"`
+		Payload []map[string]any `json:"payload"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &decoded); nil != err {
+		t.Fatalf("unmarshal streamed JSON: %v, body=%s", err, buf.String())
+	}
+	if 2 != decoded.Page || 1 != decoded.RPP {
+		t.Fatalf("expected page=2 rpp=1, got page=%d rpp=%d", decoded.Page, decoded.RPP)
+	}
+	if 1 != len(decoded.Payload) || "Widget" != decoded.Payload[0]["name"] {
+		t.Fatalf("unexpected streamed payload: %#v", decoded.Payload)
+	}
+	if _, ok := decoded.Payload[0]["password"]; ok {
+		t.Fatal(`expected "password" to be excluded from the streamed payload`)
+	}
+}
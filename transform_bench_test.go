@@ -0,0 +1,80 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// makeBenchUsers builds n synthetic User records for the transform benchmarks below.
+func makeBenchUsers(n int) []User {
+	var users = make([]User, n)
+	var now = time.Unix(0, 0).UTC()
+	for i := range users {
+		users[i] = User{
+			ID:        uuid.New(),
+			FirstName: "Jane",
+			LastName:  "Doe",
+			Age:       30,
+			JobTitle:  "Engineer",
+			Country:   "Narnia",
+			City:      "Cair Paravel",
+			Email:     "jane@example.com",
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+	}
+	return users
+}
+
+// benchmarkTransform runs Result.WithOptions(opts).With(fields...) over a payload of
+// size n, b.N times.
+func benchmarkTransform(b *testing.B, n int, opts Options) {
+	var r = Result[User]{Page: 1, RPP: int64(n), Payload: makeBenchUsers(n)}.WithOptions(opts)
+	var fields = []string{"first_name", "last_name", "age", "job_title", "country", "city", "email"}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = r.With(fields...)
+	}
+}
+
+func BenchmarkTransformSerial(b *testing.B) {
+	for _, n := range []int{100, 1_000, 10_000} {
+		b.Run(benchName(n), func(b *testing.B) {
+			benchmarkTransform(b, n, Options{Parallelism: 1})
+		})
+	}
+}
+
+func BenchmarkTransformParallel(b *testing.B) {
+	for _, n := range []int{100, 1_000, 10_000} {
+		b.Run(benchName(n), func(b *testing.B) {
+			benchmarkTransform(b, n, Options{})
+		})
+	}
+}
+
+// benchName renders a payload size as a benchmark subtest name, e.g. 10_000 -> "10000".
+func benchName(n int) string {
+	return strconv.Itoa(n)
+}
+
+func TestWithOptionsMatchesDefaultParallelism(t *testing.T) {
+	var n = 250
+	var fields = []string{"first_name", "last_name", "email"}
+	var users = makeBenchUsers(n)
+	var serial = Result[User]{Payload: users}.WithOptions(Options{Parallelism: 1}).With(fields...)
+	var parallel = Result[User]{Payload: users}.WithOptions(Options{MinBatch: 7}).With(fields...)
+	if len(serial.Payload) != len(parallel.Payload) {
+		t.Fatalf("expected equal payload lengths, got serial=%d parallel=%d", len(serial.Payload), len(parallel.Payload))
+	}
+	for i := range serial.Payload {
+		for key, value := range serial.Payload[i] {
+			if parallel.Payload[i][key] != value {
+				t.Fatalf("record %d: field %q diverged between serial and parallel runs: %v != %v", i, key, value, parallel.Payload[i][key])
+			}
+		}
+	}
+}